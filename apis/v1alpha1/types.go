@@ -26,6 +26,29 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// HTTPProxy is the URL of the proxy to be used for HTTP requests made
+	// by the Terraform provider.
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// HTTPSProxy is the URL of the proxy to be used for HTTPS requests made
+	// by the Terraform provider.
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of hosts that should be reached
+	// without going through the proxy.
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// CABundleSecretRef is a reference to a secret key that contains the PEM
+	// encoded CA bundle the Terraform provider uses to verify TLS
+	// certificates, e.g. the ones of a TLS intercepting proxy. The bundle
+	// replaces the system root CAs, so it must also contain the public root
+	// CAs needed to reach the hosts that are not behind the proxy.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
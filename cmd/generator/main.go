@@ -36,5 +36,10 @@ func main() {
 	if err != nil {
 		panic(fmt.Sprintf("cannot calculate the absolute path of %s", os.Args[1]))
 	}
-	pipeline.Run(config.GetProvider(), absRootDir)
+	pc := config.GetProvider()
+	config.ApplyResourceGates(pc)
+	if err := config.CheckGroupVersion(pc); err != nil {
+		panic(err)
+	}
+	pipeline.Run(pc, absRootDir)
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package configtest contains helpers for testing provider configurations.
+package configtest
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+)
+
+var (
+	// extractorCall matches a call of an exported function of a fully
+	// qualified package, which is the form terrajet expects extractors in,
+	// e.g. "github.com/org/provider/config/common.ARNExtractor()".
+	extractorCall = regexp.MustCompile(`^(?:[\w.\-]+/)+[\w\-]+\.[A-Z]\w*\((.*)\)$`)
+
+	// quotedArg matches the string literals in the arguments of a call.
+	quotedArg = regexp.MustCompile(`"([^"]*)"`)
+
+	// atProviderPath matches a field path in the observed state of a
+	// managed resource.
+	atProviderPath = regexp.MustCompile(`^status\.atProvider(?:\.[A-Za-z_]\w*)+$`)
+)
+
+// AssertExtractorPaths reports an error for every reference of the given
+// provider configuration whose extractor is not well-formed. An extractor is
+// well-formed if it is empty, i.e. the external name is extracted, or if it is
+// a call of an exported function of a fully qualified package whose string
+// arguments, if any, are field paths under status.atProvider.
+func AssertExtractorPaths(t testing.TB, pc *tjconfig.Provider) {
+	t.Helper()
+	names := make([]string, 0, len(pc.Resources))
+	for name := range pc.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		refs := pc.Resources[name].References
+		fields := make([]string, 0, len(refs))
+		for f := range refs {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		for _, f := range fields {
+			if e := refs[f].Extractor; !wellFormed(e) {
+				t.Errorf("%s: reference of field %q has a malformed extractor: %q", name, f, e)
+			}
+		}
+	}
+}
+
+func wellFormed(extractor string) bool {
+	if extractor == "" {
+		return true
+	}
+	m := extractorCall.FindStringSubmatch(extractor)
+	if m == nil {
+		return false
+	}
+	for _, arg := range quotedArg.FindAllStringSubmatch(m[1], -1) {
+		if !atProviderPath.MatchString(arg[1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configtest
+
+import (
+	"fmt"
+	"testing"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+// recorder records the errors reported through it instead of failing the
+// test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertExtractorPaths(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		extractor string
+		want      []string
+	}{
+		"ExternalName": {
+			reason: "An empty extractor extracts the external name and should be accepted.",
+		},
+		"Function": {
+			reason:    "A call of an exported function of a fully qualified package should be accepted.",
+			extractor: "github.com/crossplane-contrib/provider-jet-template/config/common.ARNExtractor()",
+		},
+		"AtProviderPath": {
+			reason:    "A call with a path under status.atProvider should be accepted.",
+			extractor: `github.com/crossplane-contrib/provider-jet-template/config/common.PathExtractor("status.atProvider.arn")`,
+		},
+		"MalformedPath": {
+			reason:    "A call with a path outside of status.atProvider should be reported.",
+			extractor: `github.com/crossplane-contrib/provider-jet-template/config/common.PathExtractor("status.atprovider.arn")`,
+			want: []string{
+				`aws_instance: reference of field "vpc_id" has a malformed extractor: "github.com/crossplane-contrib/provider-jet-template/config/common.PathExtractor(\"status.atprovider.arn\")"`,
+			},
+		},
+		"Unqualified": {
+			reason:    "A call of a function whose package is not fully qualified should be reported.",
+			extractor: "common.ARNExtractor()",
+			want: []string{
+				`aws_instance: reference of field "vpc_id" has a malformed extractor: "common.ARNExtractor()"`,
+			},
+		},
+		"NotACall": {
+			reason:    "An extractor that is not a function call should be reported.",
+			extractor: "github.com/crossplane-contrib/provider-jet-template/config/common.ARNExtractor",
+			want: []string{
+				`aws_instance: reference of field "vpc_id" has a malformed extractor: "github.com/crossplane-contrib/provider-jet-template/config/common.ARNExtractor"`,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &tjconfig.Provider{
+				Resources: map[string]*tjconfig.Resource{
+					"aws_instance": {
+						References: tjconfig.References{
+							"vpc_id": {Type: "VPC", Extractor: tc.extractor},
+						},
+					},
+				},
+			}
+			r := &recorder{TB: t}
+			AssertExtractorPaths(r, pc)
+			if diff := cmp.Diff(tc.want, r.errors); diff != "" {
+				t.Errorf("\n%s\nAssertExtractorPaths(...): -want errors, +got errors:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	fmtRemovalNote = "Deprecated: this field will be removed after %s."
+
+	// error messages
+	fmtErrUnknownField = "cannot schedule the removal of %q: %q has no such field"
+)
+
+// Removal is a planned removal of a deprecated field.
+type Removal struct {
+	// Resource is the Terraform resource name.
+	Resource string
+	// Field is the name of the top level field to be removed.
+	Field string
+	// RemoveAfterVersion is the last provider version the field is kept in.
+	RemoveAfterVersion string
+}
+
+// removalKey identifies the field of a scheduled removal.
+type removalKey struct {
+	resource string
+	field    string
+}
+
+// removals are the field removals that are scheduled, keyed by resource and
+// field so that scheduling the same removal again replaces the earlier one.
+var removals = map[removalKey]Removal{}
+
+// ScheduleFieldRemoval records that the given top level field of the resource
+// is deprecated and will be removed after removeAfterVersion. It panics if the
+// field is not in the embedded Terraform provider schema. Scheduling the
+// removal of the same field again replaces the earlier record. It has to be
+// called before the deprecation notes are added in GetProvider, i.e. at
+// package initialization or from one of the configure functions of
+// GetProvider.
+func ScheduleFieldRemoval(resource, field, removeAfterVersion string) {
+	if !rawFields(resource)[field] {
+		panic(errors.Errorf(fmtErrUnknownField, field, resource))
+	}
+	removals[removalKey{resource: resource, field: field}] = Removal{
+		Resource:           resource,
+		Field:              field,
+		RemoveAfterVersion: removeAfterVersion,
+	}
+}
+
+// PendingRemovals returns the scheduled field removals sorted by resource and
+// field name, to be consumed by release tooling.
+func PendingRemovals() []Removal {
+	l := make([]Removal, 0, len(removals))
+	for _, rm := range removals {
+		l = append(l, rm)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Resource != l[j].Resource {
+			return l[i].Resource < l[j].Resource
+		}
+		return l[i].Field < l[j].Field
+	})
+	return l
+}
+
+// annotateRemovals adds a deprecation note to the descriptions of the fields
+// that are scheduled for removal. Removals of resources that are not included
+// are skipped.
+func annotateRemovals(pc *tjconfig.Provider) {
+	for _, rm := range removals {
+		r, ok := pc.Resources[rm.Resource]
+		if !ok {
+			continue
+		}
+		s, ok := r.TerraformResource.Schema[rm.Field]
+		if !ok {
+			continue
+		}
+		s.Description = strings.TrimSpace(s.Description + " " + fmt.Sprintf(fmtRemovalNote, rm.RemoveAfterVersion))
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScheduleFieldRemoval(t *testing.T) {
+	type args struct {
+		resource string
+		field    string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"KnownField": {
+			reason: "A field of the embedded schema should be scheduled for removal.",
+			args: args{
+				resource: "null_resource",
+				field:    "triggers",
+			},
+		},
+		"UnknownField": {
+			reason: "A field that is not in the embedded schema should be rejected.",
+			args: args{
+				resource: "null_resource",
+				field:    "tirggers",
+			},
+			want: true,
+		},
+		"UnknownResource": {
+			reason: "A resource that is not in the embedded schema should be rejected.",
+			args: args{
+				resource: "null_resources",
+				field:    "triggers",
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer func() { removals = map[removalKey]Removal{} }()
+			got := func() (panicked bool) {
+				defer func() {
+					panicked = recover() != nil
+				}()
+				ScheduleFieldRemoval(tc.args.resource, tc.args.field, "v0.3.0")
+				return false
+			}()
+			if got != tc.want {
+				t.Errorf("\n%s\nScheduleFieldRemoval(...): want panic %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAnnotateRemovals(t *testing.T) {
+	defer func() { removals = map[removalKey]Removal{} }()
+
+	// Removals scheduled from a configure function are scheduled again on
+	// every GetProvider call, which must not duplicate them.
+	var pc *tjconfig.Provider
+	for i := 0; i < 2; i++ {
+		ScheduleFieldRemoval("null_resource", "triggers", "v0.3.0")
+		pc = GetProvider()
+	}
+
+	want := []Removal{{Resource: "null_resource", Field: "triggers", RemoveAfterVersion: "v0.3.0"}}
+	if diff := cmp.Diff(want, PendingRemovals()); diff != "" {
+		t.Errorf("PendingRemovals(): -want, +got:\n%s", diff)
+	}
+	note := fmt.Sprintf(fmtRemovalNote, "v0.3.0")
+	d := pc.Resources["null_resource"].TerraformResource.Schema["triggers"].Description
+	if !strings.HasSuffix(d, note) || strings.Count(d, note) != 1 {
+		t.Errorf("GetProvider(): the description of a field scheduled for removal should end with a single deprecation note, got %q", d)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/pkg/errors"
+)
+
+const (
+	// error messages
+	errNoID                = "cannot find id in tfstate"
+	fmtErrUnexpectedID     = "id %q does not match the format %q"
+	fmtErrMissingParameter = "cannot find the %q parameter to build the id"
+	fmtErrSeparator        = "the %q parameter cannot contain the separator %q"
+)
+
+// CompositeIdentifier returns an external name configuration for resources
+// whose Terraform ID is a composite of several arguments, e.g.
+// "<region>:<name>". The format is expected to contain one "%s" verb per
+// given field and no other verbs, and consecutive verbs have to be separated.
+// The last field is the one that holds the external name while the rest are
+// read from the parameters when the ID is built. Since the ID is parsed back by
+// splitting at the separators, a parameter value that contains the separator
+// following it is rejected.
+func CompositeIdentifier(format string, fields ...string) tjconfig.ExternalName {
+	parts := strings.Split(format, "%s")
+	if len(fields) == 0 || len(parts)-1 != len(fields) {
+		panic(fmt.Sprintf("format %q must contain exactly one %%s verb for each of the fields %v", format, fields))
+	}
+	if strings.Contains(strings.Join(parts, ""), "%") {
+		panic(fmt.Sprintf("format %q must not contain verbs other than %%s", format))
+	}
+	for _, p := range parts[1 : len(parts)-1] {
+		if p == "" {
+			panic(fmt.Sprintf("format %q must separate the %%s verbs", format))
+		}
+	}
+	// Every field but the last one is matched lazily so that the external
+	// name is allowed to contain the separator.
+	expr := "^" + regexp.QuoteMeta(parts[0])
+	for i, p := range parts[1:] {
+		group := "(.+?)"
+		if i == len(parts)-2 {
+			group = "(.+)"
+		}
+		expr += group + regexp.QuoteMeta(p)
+	}
+	re := regexp.MustCompile(expr + "$")
+	nameField := fields[len(fields)-1]
+
+	return tjconfig.ExternalName{
+		SetIdentifierArgumentFn: func(base map[string]interface{}, externalName string) {
+			base[nameField] = externalName
+		},
+		OmittedFields: []string{nameField},
+		GetExternalNameFn: func(tfstate map[string]interface{}) (string, error) {
+			id, ok := tfstate["id"].(string)
+			if !ok || id == "" {
+				return "", errors.New(errNoID)
+			}
+			m := re.FindStringSubmatch(id)
+			if m == nil {
+				return "", errors.Errorf(fmtErrUnexpectedID, id, format)
+			}
+			return m[len(m)-1], nil
+		},
+		GetIDFn: func(_ context.Context, externalName string, parameters map[string]interface{}, _ map[string]interface{}) (string, error) {
+			values := make([]interface{}, len(fields))
+			for i, f := range fields[:len(fields)-1] {
+				v, ok := parameters[f].(string)
+				if !ok || v == "" {
+					return "", errors.Errorf(fmtErrMissingParameter, f)
+				}
+				if strings.Contains(v, parts[i+1]) {
+					return "", errors.Errorf(fmtErrSeparator, f, parts[i+1])
+				}
+				values[i] = v
+			}
+			values[len(values)-1] = externalName
+			return fmt.Sprintf(format, values...), nil
+		},
+	}
+}
+
+// externalNameConfigs contains the external name configurations of the
+// resources that deviate from the default, keyed by resource name.
+var externalNameConfigs = map[string]tjconfig.ExternalName{}
+
+// SetExternalNameConfig registers the external name configuration of the
+// given resource, replacing the default one. It has to be called before
+// GetProvider, i.e. at package initialization.
+func SetExternalNameConfig(resource string, e tjconfig.ExternalName) {
+	externalNameConfigs[resource] = e
+}
+
+// ExternalNameOverrides sets the external name configuration registered with
+// SetExternalNameConfig for the resource. Resources without an entry keep the
+// default external name configuration.
+func ExternalNameOverrides() tjconfig.ResourceOption {
+	return func(r *tjconfig.Resource) {
+		if e, ok := externalNameConfigs[r.Name]; ok {
+			r.ExternalName = e
+		}
+	}
+}
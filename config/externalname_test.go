@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestCompositeIdentifier(t *testing.T) {
+	type args struct {
+		format       string
+		fields       []string
+		externalName string
+		parameters   map[string]interface{}
+	}
+	type want struct {
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Simple": {
+			reason: "The ID should be built from the parameters and the external name.",
+			args: args{
+				format:       "%s:%s",
+				fields:       []string{"region", "name"},
+				externalName: "bucket",
+				parameters:   map[string]interface{}{"region": "us-east-1"},
+			},
+			want: want{
+				id: "us-east-1:bucket",
+			},
+		},
+		"SeparatorInExternalName": {
+			reason: "The external name should be allowed to contain the separator.",
+			args: args{
+				format:       "%s/%s/%s",
+				fields:       []string{"account", "region", "name"},
+				externalName: "a/b",
+				parameters:   map[string]interface{}{"account": "123", "region": "us-east-1"},
+			},
+			want: want{
+				id: "123/us-east-1/a/b",
+			},
+		},
+		"SeparatorInParameter": {
+			reason: "A parameter containing the separator that follows it should be rejected since it cannot be parsed back.",
+			args: args{
+				format:       "%s:%s",
+				fields:       []string{"region", "name"},
+				externalName: "bucket",
+				parameters:   map[string]interface{}{"region": "us:east"},
+			},
+			want: want{
+				err: errors.Errorf(fmtErrSeparator, "region", ":"),
+			},
+		},
+		"MissingParameter": {
+			reason: "A missing parameter should be reported.",
+			args: args{
+				format:       "%s:%s",
+				fields:       []string{"region", "name"},
+				externalName: "bucket",
+				parameters:   map[string]interface{}{},
+			},
+			want: want{
+				err: errors.Errorf(fmtErrMissingParameter, "region"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := CompositeIdentifier(tc.args.format, tc.args.fields...)
+			id, err := e.GetIDFn(context.TODO(), tc.args.externalName, tc.args.parameters, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nGetIDFn(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nGetIDFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			got, err := e.GetExternalNameFn(map[string]interface{}{"id": id})
+			if err != nil {
+				t.Fatalf("\n%s\nGetExternalNameFn(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.args.externalName, got); diff != "" {
+				t.Errorf("\n%s\nGetExternalNameFn(...): the external name should survive the round trip: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCompositeIdentifierFormat(t *testing.T) {
+	type args struct {
+		format string
+		fields []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"Valid": {
+			reason: "A format with one separated %s verb per field should be accepted.",
+			args: args{
+				format: "%s:%s",
+				fields: []string{"region", "name"},
+			},
+		},
+		"CountMismatch": {
+			reason: "A format with fewer verbs than fields should be rejected.",
+			args: args{
+				format: "%s",
+				fields: []string{"region", "name"},
+			},
+			want: true,
+		},
+		"OtherVerb": {
+			reason: "A format with verbs other than %s should be rejected.",
+			args: args{
+				format: "%d:%s",
+				fields: []string{"name"},
+			},
+			want: true,
+		},
+		"Escape": {
+			reason: "A format with an escaped percent sign should be rejected.",
+			args: args{
+				format: "%s%%%s",
+				fields: []string{"region", "name"},
+			},
+			want: true,
+		},
+		"NoSeparator": {
+			reason: "A format with consecutive verbs should be rejected since the ID cannot be parsed back.",
+			args: args{
+				format: "%s%s",
+				fields: []string{"region", "name"},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := func() (panicked bool) {
+				defer func() {
+					panicked = recover() != nil
+				}()
+				CompositeIdentifier(tc.args.format, tc.args.fields...)
+				return false
+			}()
+			if got != tc.want {
+				t.Errorf("\n%s\nCompositeIdentifier(...): want panic %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExternalNameOverrides(t *testing.T) {
+	const resource = "null_resource"
+
+	cases := map[string]struct {
+		reason   string
+		register bool
+		want     bool
+	}{
+		"Registered": {
+			reason:   "The registered external name configuration should be applied.",
+			register: true,
+			want:     tjconfig.IdentifierFromProvider.DisableNameInitializer,
+		},
+		"NotRegistered": {
+			reason: "The default external name configuration should be kept if none is registered.",
+			want:   tjconfig.NameAsIdentifier.DisableNameInitializer,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.register {
+				SetExternalNameConfig(resource, tjconfig.IdentifierFromProvider)
+				defer delete(externalNameConfigs, resource)
+			}
+			got := GetProvider().Resources[resource].ExternalName.DisableNameInitializer
+			if got != tc.want {
+				t.Errorf("\n%s\nGetProvider(...): want DisableNameInitializer %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// error messages
+	fmtErrVersion = "resources must use the API version %q but the following do not: %v"
+
+	// arnPattern matches an ARN in the form of
+	// arn:partition:service:region:account-id:resource. Region and account ID
+	// are allowed to be empty since some services, like IAM and S3, omit them.
+	arnPattern    = `^arn:[^:]+:[^:]+:[^:]*:[^:]*:.+$`
+	patternMarker = "+kubebuilder:validation:Pattern="
+
+	docsPointer = " See the Terraform provider documentation for the full description."
+	ellipsis    = "..."
+)
+
+var (
+	sentenceEnd = regexp.MustCompile(`\.\s`)
+
+	// abbreviations are the words ending with a period that do not end a
+	// sentence.
+	abbreviations = map[string]bool{
+		"e.g.":    true,
+		"i.e.":    true,
+		"etc.":    true,
+		"vs.":     true,
+		"cf.":     true,
+		"approx.": true,
+	}
+)
+
+// ValidateARNFields adds a pattern marker to the description of every string
+// field whose name ends with "_arn", so that the generated CRD rejects values
+// that are not well-formed ARNs. Fields that are only computed or already
+// have a pattern marker are left untouched.
+func ValidateARNFields() tjconfig.ResourceOption {
+	return func(r *tjconfig.Resource) {
+		for name, s := range r.TerraformResource.Schema {
+			if !strings.HasSuffix(name, "_arn") || s.Type != schema.TypeString {
+				continue
+			}
+			if (s.Computed && !s.Optional) || strings.Contains(s.Description, patternMarker) {
+				continue
+			}
+			s.Description = strings.TrimSpace(s.Description + "\n" + patternMarker + "`" + arnPattern + "`")
+		}
+	}
+}
+
+// MinifyDescriptions shortens the field descriptions that are longer than
+// maxLen to their first sentence and appends a pointer to the Terraform
+// provider documentation, keeping the result within maxLen. Marker lines,
+// i.e. the ones starting with "+", are kept as they are and are not counted.
+// Descriptions that would not get shorter are left untouched.
+func MinifyDescriptions(maxLen int) tjconfig.ResourceOption {
+	return func(r *tjconfig.Resource) {
+		minifyDescriptions(r.TerraformResource.Schema, maxLen)
+	}
+}
+
+func minifyDescriptions(s map[string]*schema.Schema, maxLen int) {
+	for _, f := range s {
+		f.Description = minifyDescription(f.Description, maxLen)
+		if res, ok := f.Elem.(*schema.Resource); ok {
+			minifyDescriptions(res.Schema, maxLen)
+		}
+	}
+}
+
+func minifyDescription(d string, maxLen int) string {
+	if maxLen <= len(ellipsis) {
+		return d
+	}
+	var text, markers []string
+	for _, l := range strings.Split(d, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(l), "+") {
+			markers = append(markers, l)
+			continue
+		}
+		text = append(text, l)
+	}
+	short := strings.TrimSpace(strings.Join(text, "\n"))
+	if len(short) <= maxLen {
+		return d
+	}
+	// The pointer is dropped if there is no room left for the text.
+	pointer := docsPointer
+	limit := maxLen - len(pointer)
+	if limit <= len(ellipsis) {
+		pointer = ""
+		limit = maxLen
+	}
+	short = firstSentence(short)
+	if len(short) > limit {
+		cut := strings.LastIndex(short[:limit-len(ellipsis)+1], " ")
+		if cut <= 0 {
+			cut = limit - len(ellipsis)
+			// do not split a multi-byte character
+			for cut > 0 && !utf8.RuneStart(short[cut]) {
+				cut--
+			}
+		}
+		short = short[:cut] + ellipsis
+	}
+	result := strings.Join(append([]string{short + pointer}, markers...), "\n")
+	if len(result) >= len(d) {
+		return d
+	}
+	return result
+}
+
+// firstSentence returns the first sentence of the given text. Periods of the
+// common abbreviations are not considered as the end of a sentence.
+func firstSentence(s string) string {
+	for _, loc := range sentenceEnd.FindAllStringIndex(s, -1) {
+		word := s[strings.LastIndexAny(s[:loc[0]], " \n\t")+1 : loc[0]+1]
+		if abbreviations[strings.ToLower(word)] {
+			continue
+		}
+		return s[:loc[0]+1]
+	}
+	return s
+}
+
+// EnforceGroupVersion sets the API version of the resource to the given one,
+// so that the version is not left to terrajet's default. Resource
+// configurators must not change it; CheckGroupVersion reports the ones that
+// do.
+func EnforceGroupVersion(version string) tjconfig.ResourceOption {
+	return func(r *tjconfig.Resource) {
+		r.Version = version
+	}
+}
+
+// CheckGroupVersion returns an error listing the resources whose API version
+// is not the one of this provider, e.g. because a resource configurator
+// changed it. It is meant to be run by the code generator, so that a mismatch
+// fails the generation instead of the controller.
+func CheckGroupVersion(pc *tjconfig.Provider) error {
+	var names []string
+	for name, r := range pc.Resources {
+		if r.Version != apiVersion {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+	return errors.Errorf(fmtErrVersion, apiVersion, names)
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+func TestARNPattern(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		value  string
+		want   bool
+	}{
+		"Valid": {
+			reason: "A well-formed ARN should be accepted.",
+			value:  "arn:aws:sns:us-east-1:123456789012:topic",
+			want:   true,
+		},
+		"ValidWithoutRegionAndAccount": {
+			reason: "An ARN without region and account ID should be accepted.",
+			value:  "arn:aws:s3:::bucket",
+			want:   true,
+		},
+		"ValidWithColonInResource": {
+			reason: "An ARN whose resource part contains colons should be accepted.",
+			value:  "arn:aws:logs:us-east-1:123456789012:log-group:name:*",
+			want:   true,
+		},
+		"WrongPrefix": {
+			reason: "A value that does not start with arn should be rejected.",
+			value:  "urn:aws:sns:us-east-1:123456789012:topic",
+		},
+		"TooFewParts": {
+			reason: "A value with fewer than six parts should be rejected.",
+			value:  "arn:aws:sns:us-east-1:topic",
+		},
+		"EmptyService": {
+			reason: "A value without a service should be rejected.",
+			value:  "arn:aws::us-east-1:123456789012:topic",
+		},
+		"EmptyResource": {
+			reason: "A value without a resource should be rejected.",
+			value:  "arn:aws:sns:us-east-1:123456789012:",
+		},
+	}
+	re := regexp.MustCompile(arnPattern)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := re.MatchString(tc.value); got != tc.want {
+				t.Errorf("\n%s\nMatchString(%q): want %t, got %t", tc.reason, tc.value, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateARNFields(t *testing.T) {
+	marker := patternMarker + "`" + arnPattern + "`"
+	r := &tjconfig.Resource{
+		TerraformResource: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {Type: schema.TypeString, Optional: true, Description: "ARN of the role."},
+				"arn":      {Type: schema.TypeString, Computed: true, Description: "ARN of the resource."},
+				"kms_arn":  {Type: schema.TypeString, Computed: true, Description: "ARN of the key."},
+				"name":     {Type: schema.TypeString, Required: true, Description: "Name of the resource."},
+			},
+		},
+	}
+	ValidateARNFields()(r)
+	want := map[string]string{
+		"role_arn": "ARN of the role.\n" + marker,
+		"arn":      "ARN of the resource.",
+		"kms_arn":  "ARN of the key.",
+		"name":     "Name of the resource.",
+	}
+	got := map[string]string{}
+	for name, s := range r.TerraformResource.Schema {
+		got[name] = s.Description
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ValidateARNFields(): -want descriptions, +got descriptions:\n%s", diff)
+	}
+
+	ValidateARNFields()(r)
+	if diff := cmp.Diff(want["role_arn"], r.TerraformResource.Schema["role_arn"].Description); diff != "" {
+		t.Errorf("ValidateARNFields(): the marker should be added only once: -want, +got:\n%s", diff)
+	}
+}
+
+func TestMinifyDescription(t *testing.T) {
+	type args struct {
+		d      string
+		maxLen int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"Short": {
+			reason: "A description within the limit should be untouched.",
+			args: args{
+				d:      "Name of the bucket.",
+				maxLen: 40,
+			},
+			want: "Name of the bucket.",
+		},
+		"FirstSentence": {
+			reason: "A long description should be cut to its first sentence followed by the pointer.",
+			args: args{
+				d:      "Name of the bucket. It must be unique across all existing buckets and must comply with the naming rules.",
+				maxLen: 100,
+			},
+			want: "Name of the bucket." + docsPointer,
+		},
+		"Abbreviation": {
+			reason: "The period of an abbreviation should not be considered as the end of a sentence.",
+			args: args{
+				d:      "Use e.g. a tag to group things. It must be unique across all existing buckets and must comply with the naming rules.",
+				maxLen: 100,
+			},
+			want: "Use e.g. a tag to group things." + docsPointer,
+		},
+		"Boundary": {
+			reason: "A first sentence longer than the limit should be cut at a word boundary without the pointer if it does not fit.",
+			args: args{
+				d:      "This description has a single sentence that is much longer than the limit",
+				maxLen: 40,
+			},
+			want: "This description has a single...",
+		},
+		"MultiByte": {
+			reason: "A description without spaces should not be cut in the middle of a multi-byte character.",
+			args: args{
+				d:      strings.Repeat("é", 30),
+				maxLen: 20,
+			},
+			want: strings.Repeat("é", 8) + ellipsis,
+		},
+		"Markers": {
+			reason: "Marker lines should be preserved.",
+			args: args{
+				d:      "Name of the bucket. It must be unique across all existing buckets and must comply with the naming rules.\n+kubebuilder:validation:Required",
+				maxLen: 100,
+			},
+			want: "Name of the bucket." + docsPointer + "\n+kubebuilder:validation:Required",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := minifyDescription(tc.args.d, tc.args.maxLen)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nminifyDescription(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("\n%s\nminifyDescription(...): invalid UTF-8 in %q", tc.reason, got)
+			}
+			if text := strings.Split(got, "\n+")[0]; tc.args.d != got && len(text) > tc.args.maxLen {
+				t.Errorf("\n%s\nminifyDescription(...): length %d exceeds the limit %d", tc.reason, len(text), tc.args.maxLen)
+			}
+		})
+	}
+}
+
+func TestMinifyDescriptions(t *testing.T) {
+	long := "Name of the bucket. It must be unique across all existing buckets and must comply with the naming rules."
+	short := "Name of the bucket." + docsPointer
+	r := &tjconfig.Resource{
+		TerraformResource: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {Type: schema.TypeString, Required: true, Description: long},
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prefix": {Type: schema.TypeString, Optional: true, Description: long},
+						},
+					},
+				},
+			},
+		},
+	}
+	MinifyDescriptions(100)(r)
+	got := map[string]string{
+		"bucket":      r.TerraformResource.Schema["bucket"].Description,
+		"rule.prefix": r.TerraformResource.Schema["rule"].Elem.(*schema.Resource).Schema["prefix"].Description,
+	}
+	want := map[string]string{
+		"bucket":      short,
+		"rule.prefix": short,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MinifyDescriptions(...): -want descriptions, +got descriptions:\n%s", diff)
+	}
+}
+
+func TestEnforceGroupVersion(t *testing.T) {
+	r := tjconfig.DefaultResource("null_resource", &schema.Resource{}, EnforceGroupVersion("v1beta1"))
+	if diff := cmp.Diff("v1beta1", r.Version); diff != "" {
+		t.Errorf("EnforceGroupVersion(...): -want version, +got version:\n%s", diff)
+	}
+}
+
+func TestCheckGroupVersion(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		configure func(pc *tjconfig.Provider)
+		want      error
+	}{
+		"Default": {
+			reason:    "The provider configuration should use the API version of the provider.",
+			configure: func(pc *tjconfig.Provider) {},
+		},
+		"ConfiguratorChangesVersion": {
+			reason: "A resource whose version is changed by a configurator should be reported.",
+			configure: func(pc *tjconfig.Provider) {
+				pc.AddResourceConfigurator("null_resource", func(r *tjconfig.Resource) {
+					r.Version = "v1beta1"
+				})
+			},
+			want: errors.Errorf(fmtErrVersion, apiVersion, []string{"null_resource"}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := GetProvider()
+			tc.configure(pc)
+			pc.ConfigureResources()
+			err := CheckGroupVersion(pc)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheckGroupVersion(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
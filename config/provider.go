@@ -27,22 +27,57 @@ import (
 const (
 	resourcePrefix = "template"
 	modulePath     = "github.com/crossplane-contrib/provider-jet-template"
+	apiVersion     = "v1alpha1"
+
+	// smokeResource is the only resource included by GetProviderSmoke.
+	smokeResource = "null_resource"
 )
 
 //go:embed schema.json
 var providerSchema string
 
+// serviceResources maps short service names to the expressions matching the
+// resources that are enabled for that service.
+var serviceResources = map[string][]string{
+	"null": {
+		"^null_resource$",
+	},
+}
+
+// ResourcesForServices returns the expressions matching the resources of the
+// given services, without duplicates. Unknown service names are ignored, so
+// the returned list is empty if none of the services is known.
+func ResourcesForServices(services ...string) []string {
+	seen := map[string]bool{}
+	var l []string
+	for _, s := range services {
+		for _, e := range serviceResources[s] {
+			if seen[e] {
+				continue
+			}
+			seen[e] = true
+			l = append(l, e)
+		}
+	}
+	return l
+}
+
 // GetProvider returns provider configuration
 func GetProvider() *tjconfig.Provider {
 	defaultResourceFn := func(name string, terraformResource *schema.Resource, opts ...tjconfig.ResourceOption) *tjconfig.Resource {
-		r := tjconfig.DefaultResource(name, terraformResource)
+		r := tjconfig.DefaultResource(name, terraformResource,
+			ValidateARNFields(),
+			ExternalNameOverrides(),
+			EnforceGroupVersion(apiVersion),
+		)
 		// Add any provider-specific defaulting here. For example:
 		//   r.ExternalName = tjconfig.IdentifierFromProvider
 		return r
 	}
 
 	pc := tjconfig.NewProviderWithSchema([]byte(providerSchema), resourcePrefix, modulePath,
-		tjconfig.WithDefaultResourceFn(defaultResourceFn))
+		tjconfig.WithDefaultResourceFn(defaultResourceFn),
+		tjconfig.WithIncludeList(ResourcesForServices("null")))
 
 	for _, configure := range []func(provider *tjconfig.Provider){
 		// add custom config functions
@@ -50,6 +85,19 @@ func GetProvider() *tjconfig.Provider {
 		configure(pc)
 	}
 
+	skipKnownIssues(pc)
+	pc.ConfigureResources()
+	annotateRemovals(pc)
+	return pc
+}
+
+// GetProviderSmoke returns a provider configuration that includes only a
+// single trivial resource with none of the configuration options applied.
+// It is meant for iterating on controller logic locally, where generation
+// and startup time matter more than coverage.
+func GetProviderSmoke() *tjconfig.Provider {
+	pc := tjconfig.NewProviderWithSchema([]byte(providerSchema), resourcePrefix, modulePath,
+		tjconfig.WithIncludeList([]string{exactMatch(smokeResource)}))
 	pc.ConfigureResources()
 	return pc
 }
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-jet-template/config/configtest"
+)
+
+func TestGetProviderSmoke(t *testing.T) {
+	var got []string
+	for name := range GetProviderSmoke().Resources {
+		got = append(got, name)
+	}
+	if diff := cmp.Diff([]string{smokeResource}, got); diff != "" {
+		t.Errorf("GetProviderSmoke(): -want resources, +got resources:\n%s", diff)
+	}
+}
+
+func TestExtractorPaths(t *testing.T) {
+	configtest.AssertExtractorPaths(t, GetProvider())
+}
+
+func BenchmarkGetProvider(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		GetProvider()
+	}
+}
+
+func BenchmarkGetProviderSmoke(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		GetProviderSmoke()
+	}
+}
+
+func TestResourcesForServices(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		services []string
+		want     []string
+	}{
+		"KnownService": {
+			reason:   "All and only the expressions of a known service should be returned.",
+			services: []string{"null"},
+			want:     []string{"^null_resource$"},
+		},
+		"DuplicateService": {
+			reason:   "The expressions of a service given twice should be returned once.",
+			services: []string{"null", "null"},
+			want:     []string{"^null_resource$"},
+		},
+		"UnknownService": {
+			reason:   "No expressions should be returned for an unknown service.",
+			services: []string{"unknown"},
+		},
+		"NoService": {
+			reason: "No expressions should be returned if no service is given.",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResourcesForServices(tc.services...)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nResourcesForServices(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
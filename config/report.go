@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const (
+	// error messages
+	errUnmarshalSchema = "cannot unmarshal the provider schema"
+)
+
+// referenceSuffixes are the suffixes of the field names that plausibly point
+// to another resource.
+var referenceSuffixes = []string{"_id", "_ids", "_arn", "_arns", "_name"}
+
+// rawSchema is the part of the Terraform provider schema document that lists
+// the top level fields of the resources.
+type rawSchema struct {
+	ProviderSchemas map[string]struct {
+		ResourceSchemas map[string]struct {
+			Block struct {
+				Attributes map[string]json.RawMessage `json:"attributes"`
+				BlockTypes map[string]json.RawMessage `json:"block_types"`
+			} `json:"block"`
+		} `json:"resource_schemas"`
+	} `json:"provider_schemas"`
+}
+
+// ResourceInfo describes the API group and kind that a Terraform resource is
+// generated with.
+type ResourceInfo struct {
+	// Name is the Terraform resource name.
+	Name string
+	// ShortGroup is the group of the resource without the root group.
+	ShortGroup string
+	// Kind is the kind of the generated managed resource.
+	Kind string
+	// Overridden is true if the group or the kind differs from the one
+	// terrajet derives from the resource name by default.
+	Overridden bool
+}
+
+// DescribeResources returns the group and kind of every included resource as
+// they are computed after all configurations are applied, sorted by name.
+// It is meant to be used for reviewing group and kind changes without running
+// the code generation.
+func DescribeResources() []ResourceInfo {
+	pc := GetProvider()
+	infos := make([]ResourceInfo, 0, len(pc.Resources))
+	for name, r := range pc.Resources {
+		d := tjconfig.DefaultResource(name, r.TerraformResource)
+		infos = append(infos, ResourceInfo{
+			Name:       name,
+			ShortGroup: r.ShortGroup,
+			Kind:       r.Kind,
+			Overridden: r.ShortGroup != d.ShortGroup || r.Kind != d.Kind,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// SchemaSizeReport returns the recursive field count of every included
+// resource, keyed by Terraform resource name. The count is a proxy for the
+// size of the generated CRD and helps spotting resources that are getting
+// close to the etcd object size limit.
+func SchemaSizeReport() map[string]int {
+	pc := GetProvider()
+	report := make(map[string]int, len(pc.Resources))
+	for name, r := range pc.Resources {
+		report[name] = countFields(r.TerraformResource.Schema)
+	}
+	return report
+}
+
+// countFields returns the number of fields in the given schema map including
+// the ones in nested blocks.
+func countFields(s map[string]*schema.Schema) int {
+	n := 0
+	for _, f := range s {
+		n++
+		if res, ok := f.Elem.(*schema.Resource); ok {
+			n += countFields(res.Schema)
+		}
+	}
+	return n
+}
+
+// InjectedFields returns the sorted names of the top level fields that the
+// configuration in this package adds to the given resource, i.e. the fields
+// that are not in the embedded Terraform provider schema. It returns nil if
+// the resource is not included.
+func InjectedFields(tfName string) []string {
+	r, ok := GetProvider().Resources[tfName]
+	if !ok {
+		return nil
+	}
+	return injectedFields(r, rawFields(tfName))
+}
+
+// injectedFields returns the sorted names of the top level fields of the
+// given resource that are not in the given set of raw fields.
+func injectedFields(r *tjconfig.Resource, raw map[string]bool) []string {
+	var l []string
+	for name := range r.TerraformResource.Schema {
+		if !raw[name] {
+			l = append(l, name)
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
+// rawResources maps the names of the resources in the embedded Terraform
+// provider schema to the set of their top level fields. It is built once by
+// rawFields since the schema document can be large.
+var (
+	rawResources     map[string]map[string]bool
+	rawResourcesOnce sync.Once
+)
+
+// rawFields returns the set of top level fields the given resource has in the
+// embedded Terraform provider schema. The returned set must not be modified.
+func rawFields(tfName string) map[string]bool {
+	rawResourcesOnce.Do(func() {
+		rs := rawSchema{}
+		if err := json.Unmarshal([]byte(providerSchema), &rs); err != nil {
+			panic(errors.Wrap(err, errUnmarshalSchema))
+		}
+		rawResources = map[string]map[string]bool{}
+		for _, ps := range rs.ProviderSchemas {
+			for name, res := range ps.ResourceSchemas {
+				fields := rawResources[name]
+				if fields == nil {
+					fields = map[string]bool{}
+					rawResources[name] = fields
+				}
+				for f := range res.Block.Attributes {
+					fields[f] = true
+				}
+				for f := range res.Block.BlockTypes {
+					fields[f] = true
+				}
+			}
+		}
+	})
+	return rawResources[tfName]
+}
+
+// ReferenceCoverage returns the number of top level fields that have a
+// reference configured and the total number of settable top level fields
+// that plausibly point to another resource, i.e. the ones whose names end
+// with one of referenceSuffixes, across all included resources.
+func ReferenceCoverage() (linked, total int) {
+	return referenceCoverage(GetProvider())
+}
+
+// referenceCoverage returns the reference coverage of the resources of the
+// given provider configuration.
+func referenceCoverage(pc *tjconfig.Provider) (linked, total int) {
+	for _, r := range pc.Resources {
+		for name, s := range r.TerraformResource.Schema {
+			if (s.Computed && !s.Optional) || !referenceCandidate(name) {
+				continue
+			}
+			total++
+			if _, ok := r.References[name]; ok {
+				linked++
+			}
+		}
+	}
+	return linked, total
+}
+
+func referenceCandidate(name string) bool {
+	for _, s := range referenceSuffixes {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSchemaSizeReport(t *testing.T) {
+	want := map[string]int{"null_resource": 2}
+	if diff := cmp.Diff(want, SchemaSizeReport()); diff != "" {
+		t.Errorf("SchemaSizeReport(): -want, +got:\n%s", diff)
+	}
+}
+
+func TestDescribeResources(t *testing.T) {
+	want := []ResourceInfo{
+		{Name: "null_resource", ShortGroup: "null", Kind: "Resource", Overridden: false},
+	}
+	if diff := cmp.Diff(want, DescribeResources()); diff != "" {
+		t.Errorf("DescribeResources(): -want, +got:\n%s", diff)
+	}
+}
+
+func TestInjectedFields(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		tfName string
+	}{
+		"NoInjectedFields": {
+			reason: "A resource whose schema is not extended should have no injected fields.",
+			tfName: "null_resource",
+		},
+		"NotIncluded": {
+			reason: "A resource that is not included should have no injected fields.",
+			tfName: "unknown_resource",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff([]string(nil), InjectedFields(tc.tfName), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nInjectedFields(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInjectedFieldsExtended(t *testing.T) {
+	r := GetProvider().Resources["null_resource"]
+	r.TerraformResource.Schema["region"] = &schema.Schema{Type: schema.TypeString, Required: true}
+	want := []string{"region"}
+	if diff := cmp.Diff(want, injectedFields(r, rawFields("null_resource"))); diff != "" {
+		t.Errorf("injectedFields(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestReferenceCoverage(t *testing.T) {
+	type want struct {
+		linked int
+		total  int
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     *tjconfig.Provider
+		want   want
+	}{
+		"Mixed": {
+			reason: "Only settable reference candidates should be counted, and only the ones with a reference as linked.",
+			pc: &tjconfig.Provider{
+				Resources: map[string]*tjconfig.Resource{
+					"aws_instance": {
+						TerraformResource: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"vpc_id":      {Type: schema.TypeString, Required: true},
+								"subnet_ids":  {Type: schema.TypeList, Optional: true},
+								"arn":         {Type: schema.TypeString, Computed: true},
+								"description": {Type: schema.TypeString, Optional: true},
+							},
+						},
+						References: tjconfig.References{
+							"vpc_id": {Type: "VPC"},
+						},
+					},
+				},
+			},
+			want: want{linked: 1, total: 2},
+		},
+		"NullResource": {
+			reason: "null_resource has no reference candidates.",
+			pc:     GetProvider(),
+			want:   want{linked: 0, total: 0},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			linked, total := referenceCoverage(tc.pc)
+			if diff := cmp.Diff(tc.want, want{linked: linked, total: total}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nreferenceCoverage(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
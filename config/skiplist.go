@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"regexp"
+
+	tjconfig "github.com/crossplane/terrajet/pkg/config"
+)
+
+// gatedResources maps the names of the resources that are behind a feature
+// gate to the environment variable enabling them.
+var gatedResources = map[string]string{}
+
+// GateResource generates the given resource only if gateEnvVar is set when
+// the code generator runs. It is meant to be used for staging experimental
+// resources. It has to be called before ApplyResourceGates, i.e. at package
+// initialization or from one of the configure functions of GetProvider.
+func GateResource(resource, gateEnvVar string) {
+	gatedResources[resource] = gateEnvVar
+}
+
+// ApplyResourceGates removes the gated resources whose environment variable
+// is not set from the given provider configuration. It is meant to be called
+// by the code generator only. The provider binary keeps the configuration of
+// every gated resource so that the controllers generated while the gate was
+// set always find their configuration.
+func ApplyResourceGates(pc *tjconfig.Provider) {
+	for name, env := range gatedResources {
+		if _, ok := os.LookupEnv(env); !ok {
+			delete(pc.Resources, name)
+		}
+	}
+}
+
+// knownIssues maps the names of the resources that are skipped because of a
+// known issue to the URL tracking that issue.
+var knownIssues = map[string]string{}
+
+// MarkKnownIssue skips the given resource because of the issue at issueURL.
+// Unlike leaving the resource out silently, the skipped resource is recorded
+// and reported by KnownIssues. It has to be called before the resources are
+// skipped in GetProvider, i.e. at package initialization or from one of the
+// configure functions of GetProvider.
+func MarkKnownIssue(resource, issueURL string) {
+	knownIssues[resource] = issueURL
+}
+
+// KnownIssues returns the resources skipped because of a known issue, keyed
+// by resource name with the URL of the issue as value.
+func KnownIssues() map[string]string {
+	m := make(map[string]string, len(knownIssues))
+	for name, url := range knownIssues {
+		m[name] = url
+	}
+	return m
+}
+
+// skipKnownIssues removes the resources with a known issue from the given
+// provider configuration.
+func skipKnownIssues(pc *tjconfig.Provider) {
+	for name := range knownIssues {
+		delete(pc.Resources, name)
+	}
+}
+
+// exactMatch returns an expression matching only the given resource name.
+func exactMatch(name string) string {
+	return "^" + regexp.QuoteMeta(name) + "$"
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyResourceGates(t *testing.T) {
+	const (
+		resource = "null_resource"
+		env      = "TEST_GATE_NULL_RESOURCE"
+	)
+
+	cases := map[string]struct {
+		reason string
+		set    bool
+		want   bool
+	}{
+		"Unset": {
+			reason: "A gated resource should be excluded if its environment variable is not set.",
+			want:   false,
+		},
+		"Set": {
+			reason: "A gated resource should be included if its environment variable is set.",
+			set:    true,
+			want:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			GateResource(resource, env)
+			defer delete(gatedResources, resource)
+			// t.Setenv restores the original value once the test is done,
+			// including after the variable is unset below.
+			t.Setenv(env, "true")
+			if !tc.set {
+				if err := os.Unsetenv(env); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			pc := GetProvider()
+			if _, ok := pc.Resources[resource]; !ok {
+				t.Errorf("\n%s\nGetProvider(...): the runtime provider should not apply gates", tc.reason)
+			}
+			ApplyResourceGates(pc)
+			if _, got := pc.Resources[resource]; got != tc.want {
+				t.Errorf("\n%s\nApplyResourceGates(...): want included %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMarkKnownIssue(t *testing.T) {
+	const (
+		resource = "null_resource"
+		issueURL = "https://example.com/issues/1"
+	)
+	MarkKnownIssue(resource, issueURL)
+	defer delete(knownIssues, resource)
+
+	if diff := cmp.Diff(map[string]string{resource: issueURL}, KnownIssues()); diff != "" {
+		t.Errorf("KnownIssues(): -want, +got:\n%s", diff)
+	}
+	if _, ok := GetProvider().Resources[resource]; ok {
+		t.Errorf("GetProvider(): a resource with a known issue should be excluded")
+	}
+}
+
+func TestSkipKnownIssues(t *testing.T) {
+	const resource = "null_resource"
+
+	// A resource marked from a configure function is marked after the
+	// provider configuration is built.
+	pc := GetProvider()
+	MarkKnownIssue(resource, "https://example.com/issues/1")
+	defer delete(knownIssues, resource)
+	skipKnownIssues(pc)
+
+	if _, ok := pc.Resources[resource]; ok {
+		t.Errorf("skipKnownIssues(...): a resource marked after the configuration is built should be excluded")
+	}
+}
@@ -17,10 +17,14 @@ limitations under the License.
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/terrajet/pkg/terraform"
 	"github.com/pkg/errors"
@@ -38,6 +42,16 @@ const (
 	// Template credentials environment variable names
 	envUsername = "HASHICUPS_USERNAME"
 	envPassword = "HASHICUPS_PASSWORD"
+
+	// proxy environment variable names
+	envHTTPProxy  = "HTTP_PROXY"
+	envHTTPSProxy = "HTTPS_PROXY"
+	envNoProxy    = "NO_PROXY"
+
+	// envSSLCertFile is the environment variable that makes the Terraform
+	// provider use the given CA bundle instead of the system one. It replaces
+	// the system root CAs rather than adding to them.
+	envSSLCertFile = "SSL_CERT_FILE"
 )
 
 const (
@@ -49,8 +63,16 @@ const (
 	errTrackUsage           = "cannot track ProviderConfig usage"
 	errExtractCredentials   = "cannot extract credentials"
 	errUnmarshalCredentials = "cannot unmarshal template credentials as JSON"
+	errExtractCABundle      = "cannot extract CA bundle"
+	errEmptyCABundle        = "CA bundle is empty"
+	errWriteCABundle        = "cannot write CA bundle"
 )
 
+// caBundleDir is the directory on the controller filesystem the CA bundles
+// are written to. The Terraform provider runs as a child process of the
+// controller, so it can read them from there.
+var caBundleDir = filepath.Join(os.TempDir(), "ca-bundles")
+
 // TerraformSetupBuilder builds Terraform a terraform.SetupFn function which
 // returns Terraform provider setup configuration
 func TerraformSetupBuilder(version, providerSource, providerVersion string) terraform.SetupFn {
@@ -77,6 +99,25 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 			return ps, errors.Wrap(err, errTrackUsage)
 		}
 
+		// set environment variables for the proxy configuration
+		ps.Env = proxyEnv(pc.Spec)
+
+		// make the Terraform provider trust the configured CA bundle
+		if ref := pc.Spec.CABundleSecretRef; ref != nil {
+			data, err := resource.ExtractSecret(ctx, client, xpv1.CommonCredentialSelectors{SecretRef: ref})
+			if err != nil {
+				return ps, errors.Wrap(err, errExtractCABundle)
+			}
+			if len(data) == 0 {
+				return ps, errors.New(errEmptyCABundle)
+			}
+			path, err := writeCABundle(caBundleDir, pc.Name, data)
+			if err != nil {
+				return ps, errors.Wrap(err, errWriteCABundle)
+			}
+			ps.Env = append(ps.Env, fmt.Sprintf(fmtEnvVar, envSSLCertFile, path))
+		}
+
 		data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, client, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
 			return ps, errors.Wrap(err, errExtractCredentials)
@@ -91,10 +132,53 @@ func TerraformSetupBuilder(version, providerSource, providerVersion string) terr
 			"host": templateCreds[keyHost],
 		}
 		// set environment variables for sensitive provider configuration
-		ps.Env = []string{
+		ps.Env = append(ps.Env,
 			fmt.Sprintf(fmtEnvVar, envUsername, templateCreds[keyUsername]),
 			fmt.Sprintf(fmtEnvVar, envPassword, templateCreds[keyPassword]),
-		}
+		)
 		return ps, nil
 	}
 }
+
+// proxyEnv returns the environment variables that make the Terraform provider
+// use the proxy configured in the given ProviderConfig spec.
+func proxyEnv(spec v1alpha1.ProviderConfigSpec) []string {
+	var env []string
+	for _, e := range []struct{ name, value string }{
+		{name: envHTTPProxy, value: spec.HTTPProxy},
+		{name: envHTTPSProxy, value: spec.HTTPSProxy},
+		{name: envNoProxy, value: spec.NoProxy},
+	} {
+		if e.value != "" {
+			env = append(env, fmt.Sprintf(fmtEnvVar, e.name, e.value))
+		}
+	}
+	return env
+}
+
+// writeCABundle writes the given CA bundle to a file named after the
+// ProviderConfig in dir and returns its path. The file is left untouched if it
+// already has the given content, and otherwise replaced atomically so that a
+// Terraform provider that is already running never reads a partial bundle.
+func writeCABundle(dir, name string, data []byte) (string, error) {
+	path := filepath.Join(dir, name+".pem")
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+		return path, nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp(dir, name+"-*.pem")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+	if _, err := f.Write(data); err != nil {
+		f.Close() //nolint:errcheck
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return path, os.Rename(f.Name(), path)
+}
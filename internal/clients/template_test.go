@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-jet-template/apis/v1alpha1"
+)
+
+func TestProxyEnv(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   v1alpha1.ProviderConfigSpec
+		want   []string
+	}{
+		"NoProxy": {
+			reason: "No environment variables should be set if no proxy is configured.",
+			spec:   v1alpha1.ProviderConfigSpec{},
+		},
+		"AllSettings": {
+			reason: "Every configured proxy setting should be set as an environment variable.",
+			spec: v1alpha1.ProviderConfigSpec{
+				HTTPProxy:  "http://proxy:3128",
+				HTTPSProxy: "http://proxy:3129",
+				NoProxy:    "localhost,10.0.0.0/8",
+			},
+			want: []string{
+				"HTTP_PROXY=http://proxy:3128",
+				"HTTPS_PROXY=http://proxy:3129",
+				"NO_PROXY=localhost,10.0.0.0/8",
+			},
+		},
+		"PartialSettings": {
+			reason: "Proxy settings that are not configured should be omitted.",
+			spec: v1alpha1.ProviderConfigSpec{
+				HTTPSProxy: "http://proxy:3129",
+			},
+			want: []string{
+				"HTTPS_PROXY=http://proxy:3129",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := proxyEnv(tc.spec)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nproxyEnv(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWriteCABundle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "ca-bundles")
+	for _, bundle := range []string{"first", "second"} {
+		path, err := writeCABundle(dir, "default", []byte(bundle))
+		if err != nil {
+			t.Fatalf("writeCABundle(...): unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(filepath.Join(dir, "default.pem"), path); diff != "" {
+			t.Errorf("writeCABundle(...): -want path, +got path:\n%s", diff)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("cannot read the CA bundle: %s", err)
+		}
+		if diff := cmp.Diff(bundle, string(got)); diff != "" {
+			t.Errorf("writeCABundle(...): the bundle should be replaced: -want, +got:\n%s", diff)
+		}
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cannot read the CA bundle directory: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("writeCABundle(...): temporary files should be cleaned up, got %d entries", len(entries))
+	}
+}
+
+func TestWriteCABundleUnchanged(t *testing.T) {
+	dir := t.TempDir()
+	path, err := writeCABundle(dir, "default", []byte("first"))
+	if err != nil {
+		t.Fatalf("writeCABundle(...): unexpected error: %s", err)
+	}
+	old := time.Unix(3600, 0)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatalf("cannot set the modification time: %s", err)
+	}
+
+	if _, err := writeCABundle(dir, "default", []byte("first")); err != nil {
+		t.Fatalf("writeCABundle(...): unexpected error: %s", err)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("cannot stat the CA bundle: %s", err)
+	}
+	if !fi.ModTime().Equal(old) {
+		t.Errorf("writeCABundle(...): an unchanged bundle should not be written again")
+	}
+
+	if _, err := writeCABundle(dir, "default", []byte("second")); err != nil {
+		t.Fatalf("writeCABundle(...): unexpected error: %s", err)
+	}
+	fi, err = os.Stat(path)
+	if err != nil {
+		t.Fatalf("cannot stat the CA bundle: %s", err)
+	}
+	if fi.ModTime().Equal(old) {
+		t.Errorf("writeCABundle(...): a changed bundle should be written")
+	}
+}